@@ -13,6 +13,8 @@ import (
 	"github.com/golang/freetype"
 	"github.com/golang/freetype/truetype"
 	"golang.org/x/image/font"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/font/sfnt"
 	"golang.org/x/image/math/fixed"
 )
 
@@ -29,33 +31,58 @@ type Font struct {
 	Size    float64
 	BG      color.Color
 	FG      color.Color
-	TTF     *truetype.Font
-	face    font.Face
+	// TTF is the parsed TrueType font this Font was loaded from.
+	//
+	// Deprecated: TTF is nil for OpenType/CFF fonts, which are parsed via
+	// golang.org/x/image/font/sfnt instead of freetype/truetype. Use Face
+	// instead, which works for both formats.
+	TTF *truetype.Font
+	otf *sfnt.Font // set instead of TTF when URL is a CFF-flavored OpenType font
+
+	// Fallbacks are additional fonts consulted, in order, for any rune this
+	// Font's own face has no glyph for. This is how mixed-script text such as
+	// "Hello 世界 🙂" renders correctly from a single Font: declare a primary
+	// Latin font plus a CJK/emoji Fallback and draw the whole string as-is,
+	// without splitting it by script yourself. Render and RenderNRGBA draw with
+	// this Font's own TTF only and do not consult Fallbacks.
+	Fallbacks []*Font
+
+	// Kerning controls whether consecutive glyphs are nudged closer together
+	// or further apart using the font's own kerning table (e.g. tightening
+	// pairs like "AV" or "To"). It defaults to true as of Create/CreatePreloaded;
+	// set it to false afterwards to disable kerning for this Font. Render and
+	// RenderNRGBA draw through freetype, which applies the font's kerning
+	// whatever this field is set to.
+	Kerning bool
+
+	face font.Face
+}
+
+// Face returns the font.Face backing this Font. It is produced from TTF for
+// TrueType fonts and from the sfnt-parsed font for OpenType/CFF fonts, so code
+// that only needs glyph metrics or rendering can use it without caring which
+// format was loaded.
+func (f *Font) Face() font.Face {
+	return f.face
 }
 
 // Create is for loading fonts from the disk, given a location
 func (f *Font) Create() error {
 	// Read and parse the font
-	ttfBytes, err := ioutil.ReadFile(f.URL)
+	fontBytes, err := ioutil.ReadFile(f.URL)
 	if err != nil {
 		return err
 	}
 
-	ttf, err := freetype.ParseFont(ttfBytes)
-	if err != nil {
-		return err
-	}
-	f.TTF = ttf
-	f.face = truetype.NewFace(f.TTF, &truetype.Options{
-		Size:    f.Size,
-		DPI:     dpi,
-		Hinting: font.HintingFull,
-	})
-
-	return nil
+	return f.parse(fontBytes)
 }
 
-// CreatePreloaded is for loading fonts which have already been defined (and loaded) within Preload
+// CreatePreloaded is for loading fonts which have already been defined (and loaded) within Preload.
+//
+// Unlike Create, CreatePreloaded only supports TrueType fonts: FontResource
+// carries an already-parsed *truetype.Font, so there is no raw font data here
+// to sniff for the CFF-flavored OpenType signature parse checks for. Preload
+// an OpenType/CFF font with Create instead.
 func (f *Font) CreatePreloaded() error {
 	fontres, err := engo.Files.Resource(f.URL)
 	if err != nil {
@@ -73,46 +100,159 @@ func (f *Font) CreatePreloaded() error {
 		DPI:     dpi,
 		Hinting: font.HintingFull,
 	})
+	f.Kerning = true
+	return nil
+}
+
+// parse detects whether data is a CFF-flavored OpenType font or a TrueType
+// (glyf-outline) font and parses it with the appropriate package, since
+// freetype/truetype can't read CFF outlines.
+func (f *Font) parse(data []byte) error {
+	f.Kerning = true
+
+	if !isOpenTypeCFF(data) {
+		ttf, err := freetype.ParseFont(data)
+		if err != nil {
+			return err
+		}
+		f.TTF = ttf
+		f.otf = nil
+		f.face = truetype.NewFace(f.TTF, &truetype.Options{
+			Size:    f.Size,
+			DPI:     dpi,
+			Hinting: font.HintingFull,
+		})
+		return nil
+	}
+
+	otf, err := sfnt.Parse(data)
+	if err != nil {
+		return err
+	}
+	f.otf = otf
+	f.TTF = nil
+	face, err := opentype.NewFace(otf, &opentype.FaceOptions{
+		Size:    f.Size,
+		DPI:     dpi,
+		Hinting: font.HintingFull,
+	})
+	if err != nil {
+		return err
+	}
+	f.face = face
 	return nil
 }
 
+// isOpenTypeCFF reports whether data's sfnt header signature is "OTTO", the
+// marker for CFF-flavored OpenType fonts. TrueType-flavored fonts (including
+// those starting with "\x00\x01\x00\x00", "true" or "typ1") fall through to
+// freetype/truetype, which doesn't understand PostScript/CFF outlines.
+func isOpenTypeCFF(data []byte) bool {
+	return len(data) >= 4 && string(data[:4]) == "OTTO"
+}
+
+// newFace rebuilds a font.Face for this Font's underlying font data at the
+// given hinting, dispatching to whichever package parsed the font. It's used
+// where a different hinting than the one Create used is needed, such as the
+// unhinted glyphs generateFontAtlas packs into a texture.
+func (f *Font) newFace(hinting font.Hinting) font.Face {
+	if f.otf != nil {
+		face, err := opentype.NewFace(f.otf, &opentype.FaceOptions{
+			Size:    f.Size,
+			DPI:     dpi,
+			Hinting: hinting,
+		})
+		if err != nil {
+			log.Println(err)
+			return f.face
+		}
+		return face
+	}
+	return truetype.NewFace(f.TTF, &truetype.Options{
+		Size:    f.Size,
+		DPI:     dpi,
+		Hinting: hinting,
+	})
+}
+
+// faceForRune returns the font.Face that should draw r: this Font's own face
+// if it has the glyph, otherwise the face of the first Fallback (searched
+// recursively through its own Fallbacks) that does, otherwise this Font's own
+// face again so an unsupported rune still draws as .notdef rather than being
+// skipped.
+func (f *Font) faceForRune(r rune) font.Face {
+	if hasGlyph(f, r) || len(f.Fallbacks) == 0 {
+		return f.face
+	}
+	if fb := f.firstFallbackWithGlyph(r); fb != nil {
+		return fb.faceForRune(r)
+	}
+	return f.face
+}
+
+// ppem returns this Font's scale, in 26.6 fixed-point pixels per em, as used
+// by the TrueType/OpenType kerning tables.
+func (f *Font) ppem() fixed.Int26_6 {
+	return fixed.Int26_6(f.Size * dpi / 72 * 64)
+}
+
+// kern returns the pixel adjustment to apply to the pen position after
+// drawing prev and before drawing curr, looked up from the font's own
+// kerning table. It returns 0 if Kerning is disabled, prev is the zero rune
+// (meaning there's no previous glyph), or the font has no kerning data for
+// that pair.
+func (f *Font) kern(prev, curr rune) fixed.Int26_6 {
+	if !f.Kerning || prev == 0 {
+		return 0
+	}
+	switch {
+	case f.otf != nil:
+		i0, _ := f.otf.GlyphIndex(nil, prev)
+		i1, _ := f.otf.GlyphIndex(nil, curr)
+		k, err := f.otf.Kern(nil, i0, i1, f.ppem(), font.HintingNone)
+		if err != nil {
+			return 0
+		}
+		return k
+	case f.TTF != nil:
+		return f.TTF.Kern(f.ppem(), f.TTF.Index(prev), f.TTF.Index(curr))
+	default:
+		return 0
+	}
+}
+
 // TextDimensions returns the total width, total height and total line size
 // of the input string written out in the Font.
 func (f *Font) TextDimensions(text string) (int, int, int) {
-	fnt := f.TTF
-	size := f.Size
 	var (
 		totalWidth  = fixed.Int26_6(0)
-		totalHeight = fixed.Int26_6(size)
+		totalHeight = fixed.Int26_6(f.Size * 64)
 		maxYBearing = fixed.Int26_6(0)
+		prev        rune
 	)
-	fupe := fixed.Int26_6(fnt.FUnitsPerEm())
 
 	for _, char := range text {
-		idx := fnt.Index(char)
-		hm := fnt.HMetric(fupe, idx)
-		vm := fnt.VMetric(fupe, idx)
-		g := truetype.GlyphBuf{}
-		err := g.Load(fnt, fupe, idx, font.HintingNone)
-		if err != nil {
-			log.Println(err)
-			return 0, 0, 0
+		face := f.faceForRune(char)
+
+		adv, ok := face.GlyphAdvance(char)
+		if !ok {
+			continue
 		}
-		totalWidth += hm.AdvanceWidth
+		totalWidth += f.kern(prev, char) + adv
+		prev = char
 
-		yB := (vm.TopSideBearing * fixed.Int26_6(size)) / fupe
-		if yB > maxYBearing {
-			maxYBearing = yB
+		if bounds, _, ok := face.GlyphBounds(char); ok && -bounds.Min.Y > maxYBearing {
+			maxYBearing = -bounds.Min.Y
 		}
-		dY := (vm.AdvanceHeight * fixed.Int26_6(size)) / fupe
-		if dY > totalHeight {
-			totalHeight = dY
+		if h := face.Metrics().Height; h > totalHeight {
+			totalHeight = h
 		}
 	}
 
-	// Scale to actual pixel size
-	totalWidth *= fixed.Int26_6(size)
-	totalWidth /= fupe
+	// Convert from 26.6 fixed point to whole pixels, as RenderNRGBA expects.
+	totalWidth = fixed.Int26_6(totalWidth.Ceil())
+	totalHeight = fixed.Int26_6(totalHeight.Ceil())
+	maxYBearing = fixed.Int26_6(maxYBearing.Ceil())
 
 	return int(totalWidth), int(totalHeight), int(maxYBearing)
 }
@@ -126,6 +266,10 @@ func (f *Font) RenderNRGBA(text string) *image.NRGBA {
 	if size <= 0 {
 		panic("Font size cannot be <= 0")
 	}
+	if font == nil {
+		log.Println("Render and RenderNRGBA require a TrueType-backed Font; OpenType/CFF fonts aren't supported by this path yet")
+		return nil
+	}
 
 	// Default colors
 	if f.FG == nil {
@@ -173,7 +317,72 @@ func (f *Font) Render(text string) Texture {
 }
 
 // generateFontAtlas generates the font atlas for this given font, using the first `c` Unicode characters.
+// Runes this Font's own face has no glyph for are handed off to the first entry in
+// Fallbacks that does have one, and packed into a separate sub-atlas under
+// FontAtlas.Fallbacks rather than into the primary atlas.
 func (f *Font) generateFontAtlas(rs []rune) FontAtlas {
+	primary := rs
+	var fallbackRunes map[*Font][]rune
+	if len(f.Fallbacks) > 0 {
+		primary = make([]rune, 0, len(rs))
+		fallbackRunes = make(map[*Font][]rune)
+		for _, r := range rs {
+			if hasGlyph(f, r) {
+				primary = append(primary, r)
+				continue
+			}
+			if fb := f.firstFallbackWithGlyph(r); fb != nil {
+				fallbackRunes[fb] = append(fallbackRunes[fb], r)
+				continue
+			}
+			// No face in the chain has it either; keep it in the primary atlas so
+			// it still renders as that face's .notdef glyph instead of vanishing.
+			primary = append(primary, r)
+		}
+	}
+
+	atlas := f.packAtlas(primary)
+	for fb, fbRunes := range fallbackRunes {
+		if atlas.Fallbacks == nil {
+			atlas.Fallbacks = make(map[*Font]FontAtlas, len(fallbackRunes))
+		}
+		atlas.Fallbacks[fb] = fb.generateFontAtlas(fbRunes)
+	}
+	return atlas
+}
+
+// hasGlyph reports whether fnt's face has an actual glyph for r, as opposed to
+// falling back to the .notdef glyph (index 0).
+func hasGlyph(fnt *Font, r rune) bool {
+	if fnt.otf != nil {
+		idx, err := fnt.otf.GlyphIndex(nil, r)
+		return err == nil && idx != 0
+	}
+	if fnt.TTF != nil {
+		return fnt.TTF.Index(r) != 0
+	}
+	return false
+}
+
+// firstFallbackWithGlyph returns the first Font reachable from f.Fallbacks,
+// searched depth-first and recursively through each fallback's own Fallbacks,
+// whose face has a glyph for r. It returns nil if none of them do.
+func (f *Font) firstFallbackWithGlyph(r rune) *Font {
+	for _, fb := range f.Fallbacks {
+		if hasGlyph(fb, r) {
+			return fb
+		}
+		if nested := fb.firstFallbackWithGlyph(r); nested != nil {
+			return nested
+		}
+	}
+	return nil
+}
+
+// packAtlas packs and renders rs into a FontAtlas using this Font's own face,
+// without considering Fallbacks. It's the part of generateFontAtlas that's also
+// reused, recursively, to build each fallback Font's own sub-atlas.
+func (f *Font) packAtlas(rs []rune) FontAtlas {
 	atlas := FontAtlas{
 		XLocation: make(map[rune]float32, len(rs)),
 		YLocation: make(map[rune]float32, len(rs)),
@@ -194,16 +403,17 @@ func (f *Font) generateFontAtlas(rs []rune) FontAtlas {
 
 	d := &font.Drawer{}
 	d.Src = image.NewUniform(f.FG)
-	d.Face = truetype.NewFace(f.TTF, &truetype.Options{
-		Size:    f.Size,
-		DPI:     dpi,
-		Hinting: font.HintingNone,
-	})
+	d.Face = f.newFace(font.HintingNone)
 
-	lineHeight := fixed.Int26_6(int32(d.Face.Metrics().Height)+2)
+	faceMetrics := d.Face.Metrics()
+	lineHeight := fixed.Int26_6(int32(faceMetrics.Height)+2)
 	lineBuffer := float32(lineHeight.Ceil()) / 2
 	xBuffer := float32(10)
 
+	atlas.Ascent = float32(faceMetrics.Ascent.Ceil())
+	atlas.Descent = float32(faceMetrics.Descent.Ceil())
+	atlas.LineHeight = float32(lineHeight.Ceil()) + lineBuffer
+
 	for idxr, r := range rs {
 		_, adv, ok := d.Face.GlyphBounds(r)
 		if !ok {
@@ -246,6 +456,17 @@ func (f *Font) generateFontAtlas(rs []rune) FontAtlas {
 	imObj := NewImageObject(actual)
 	atlas.Texture = NewTextureSingle(imObj).id
 
+	if f.Kerning {
+		atlas.Kerning = make(map[[2]rune]float32)
+		for _, prev := range rs {
+			for _, curr := range rs {
+				if k := f.kern(prev, curr); k != 0 {
+					atlas.Kerning[[2]rune{prev, curr}] = float32(k) / 64
+				}
+			}
+		}
+	}
+
 	return atlas
 }
 
@@ -255,6 +476,26 @@ func (f *Font) GenerateFontAtlas(rs []rune) FontAtlas {
 	return f.generateFontAtlas(rs)
 }
 
+// LoadPrecomputedAtlas registers a FontAtlas that was baked ahead of time by the
+// fontgen tool (see common/fontgen), together with the raw NRGBA pixels that go
+// with it. Text rendered with this Font will use the given atlas directly instead
+// of calling generateFontAtlas, which skips the first-frame cost of rasterizing
+// every glyph. Note that this package still imports freetype/truetype at build
+// time for Create/CreatePreloaded, so a binary that only ever calls
+// LoadPrecomputedAtlas still links them; it just never exercises them. pix must
+// be row-major NRGBA data with a stride of 4*atlas.TotalWidth bytes per row,
+// matching the image fontgen produced.
+func (f *Font) LoadPrecomputedAtlas(atlas FontAtlas, pix []byte) {
+	img := &image.NRGBA{
+		Pix:    pix,
+		Stride: 4 * int(atlas.TotalWidth),
+		Rect:   image.Rect(0, 0, int(atlas.TotalWidth), int(atlas.TotalHeight)),
+	}
+	imObj := NewImageObject(img)
+	atlas.Texture = NewTextureSingle(imObj).id
+	atlasCache[f] = atlas
+}
+
 // A FontAtlas is a representation of some of the Font characters, as an image
 type FontAtlas struct {
 	Texture *gl.Texture
@@ -272,92 +513,235 @@ type FontAtlas struct {
 	// TotalHeight is the total amount of pixels the `FontAtlas` is high; useful for determining the `Viewport`,
 	// which is relative to this value.
 	TotalHeight float32
+	// Ascent is the distance in pixels from the top of a line to its baseline.
+	Ascent float32
+	// Descent is the distance in pixels from a line's baseline to the bottom of the line.
+	Descent float32
+	// LineHeight is the recommended distance in pixels between the baselines of consecutive lines.
+	LineHeight float32
+	// Fallbacks holds a sub-atlas for each Font reachable from the owning Font's
+	// Fallbacks (including, recursively, their own Fallbacks) that supplied at
+	// least one glyph, keyed by that *Font. A rune
+	// missing from XLocation/Width/etc above should be looked up in here next.
+	Fallbacks map[*Font]FontAtlas
+	// Kerning caches the pixel adjustment to apply between every pair of
+	// glyphs in this atlas, keyed by [2]rune{prev, curr}, so Text.Width and
+	// the render path get O(1) kerning lookups instead of querying the
+	// font's kerning table on every draw. Pairs with no adjustment are
+	// omitted rather than stored as 0.
+	Kerning map[[2]rune]float32
 }
 
-// Text represents a string drawn onto the screen, as used by the `TextShader`.
-type Text struct {
-	// Font is the reference to the font you're using to render this. This includes the color, as well as the font size.
-	Font *Font
-	// Text is the actual text you want to draw. This may include newlines (\n).
-	Text string
-	// LineSpacing is the amount of additional spacing there is between the lines (when `Text` consists of multiple lines),
-	// relative to the `Size` of the `Font`.
-	LineSpacing float32
-	// LetterSpacing is the amount of additional spacing there is between the characters, relative to the `Size` of
-	// the `Font`.
-	LetterSpacing float32
-	// RightToLeft is an experimental variable used to indicate that subsequent characters come to the left of the
-	// previous character.
-	RightToLeft bool
-	WordWrap bool
-	MaxWidth float32
+// glyphWidth returns the baked width of r in atlas, checking atlas.Fallbacks
+// when r isn't one of the primary Font's own glyphs.
+func glyphWidth(atlas FontAtlas, r rune) float32 {
+	if w, ok := atlas.Width[r]; ok {
+		return w
+	}
+	for _, sub := range atlas.Fallbacks {
+		if w, ok := sub.Width[r]; ok {
+			return w
+		}
+	}
+	return 0
 }
 
-// Texture returns nil because the Text is generated from a FontAtlas. This implements the common.Drawable interface.
-func (t Text) Texture() *gl.Texture { return nil }
+// HAlign is the horizontal alignment of each line within a block of Text,
+// relative to the block's overall width (the widest line, or MaxWidth when
+// word-wrapping).
+type HAlign uint8
+
+const (
+	// AlignLeft draws each line starting at the block's left edge. This is the default.
+	AlignLeft HAlign = iota
+	// AlignCenter centers each line within the block's width.
+	AlignCenter
+	// AlignRight draws each line ending at the block's right edge.
+	AlignRight
+	// AlignJustify stretches the gaps between words so every line but the
+	// last exactly fills the block's width. A line without any space is left
+	// at its natural width.
+	AlignJustify
+)
 
-// Width returns the width of the Text generated from a FontAtlas. This implements the common.Drawable interface.
-func (t Text) Width() float32 {
-	atlas, ok := atlasCache[t.Font]
-	if !ok {
-		// Generate texture first
-		if t.Font.Letters == "" {
-			atlas = t.Font.generateFontAtlas(Letters)
-		} else {
-			atlas = t.Font.generateFontAtlas([]rune(t.Font.Letters))
-		}
-		atlasCache[t.Font] = atlas
+// VAlign is the vertical alignment of a block of Text. It doubles as the
+// anchor point: it determines which part of the block `SpaceComponent.Position`
+// corresponds to.
+type VAlign uint8
+
+const (
+	// AlignTop anchors Position to the top of the first line's ascender. This
+	// is the default, matching Text's historical top-left-origin behavior.
+	AlignTop VAlign = iota
+	// AlignMiddle anchors Position to the vertical center of the block.
+	AlignMiddle
+	// AlignBaseline anchors Position to the baseline of the first line, so
+	// text can be placed at a specific Y without manually subtracting the
+	// font's ascender.
+	AlignBaseline
+	// AlignBottom anchors Position to the bottom of the last line's descender.
+	AlignBottom
+)
+
+// lineBox describes one laid-out line of Text: its glyphs, its measured
+// width, and the horizontal/justification adjustments HAlign calls for. It's
+// produced by layoutText and consumed by Width, Height, and a custom text
+// shader's vertex generation.
+type lineBox struct {
+	runes []rune
+	// width is the line's natural width: the sum of its glyphs' advances,
+	// kerning and LetterSpacing, before any HAlign adjustment.
+	width float32
+	// xOffset is the distance this line should be shifted right to satisfy
+	// HAlign (0 for AlignLeft).
+	xOffset float32
+	// wordGaps is the number of inter-word spaces in this line, i.e. the
+	// number of places AlignJustify can distribute extra width into.
+	wordGaps int
+	// justifyGap is the extra width, in pixels, AlignJustify adds to each of
+	// this line's wordGaps. It's 0 unless HAlign is AlignJustify.
+	justifyGap float32
+	// ascent, descent and height are this line's vertical metrics, taken
+	// from the FontAtlas's face metrics.
+	ascent, descent, height float32
+}
+
+// layoutText splits t.Text into lines - breaking on '\n' and, when t.WordWrap
+// is set, wherever the next word would cross t.MaxWidth - and measures each
+// one against atlas. Width, Height and a custom text shader all share this
+// single pass instead of re-deriving line breaks independently.
+func layoutText(t Text, atlas FontAtlas) []lineBox {
+	lineHeight := atlas.LineHeight + t.LineSpacing*atlas.LineHeight
+	if lineHeight == 0 {
+		// Atlas predates face-metric baking (e.g. a hand-built FontAtlas); fall
+		// back to the historical 'q'-height approximation.
+		lineHeight = atlas.Height['q'] + t.LineSpacing*atlas.Height['q']
 	}
 
-	var currentX float32
-	var greatestX float32
+	var lines []lineBox
+	cur := lineBox{ascent: atlas.Ascent, descent: atlas.Descent, height: lineHeight}
+	var prev rune
+
+	flush := func() {
+		lines = append(lines, cur)
+		cur = lineBox{ascent: atlas.Ascent, descent: atlas.Descent, height: lineHeight}
+		prev = 0
+	}
 
 	runes := []rune(t.Text)
 	for index, r := range runes {
 		// analyze wordwrap
 		if t.WordWrap && r == ' ' {
 			futureWidth := float32(0)
-			for idx := index+1; idx < len(runes); idx++ {
-				if r := runes[idx]; r == ' ' || r == '\n' {
+			for idx := index + 1; idx < len(runes); idx++ {
+				if rr := runes[idx]; rr == ' ' || rr == '\n' {
 					break
 				}
-				futureWidth += atlas.Width[runes[idx]] + float32(t.Font.Size)*t.LetterSpacing
+				futureWidth += glyphWidth(atlas, runes[idx]) + float32(t.Font.Size)*t.LetterSpacing
 			}
-			if t.MaxWidth < currentX + atlas.Width[r] + float32(t.Font.Size)*t.LetterSpacing + futureWidth {
-				if currentX > greatestX {
-					greatestX = currentX
-				}
-				currentX = 0
+			if t.MaxWidth < cur.width+glyphWidth(atlas, r)+float32(t.Font.Size)*t.LetterSpacing+futureWidth {
+				flush()
 				continue
 			}
 		}
 		// TODO: this might not work for all characters
 		switch {
 		case r == '\n':
-			if currentX > greatestX {
-				greatestX = currentX
-			}
-			currentX = 0
+			flush()
 			continue
 		case r == ' ':
-			break
+			cur.wordGaps++
 		case r < ' ': // all system stuff should be ignored
 			continue
 		}
 
-		currentX += atlas.Width[r] + float32(t.Font.Size)*t.LetterSpacing
+		cur.width += t.kernAdjust(atlas, prev, r) + glyphWidth(atlas, r) + float32(t.Font.Size)*t.LetterSpacing
+		cur.runes = append(cur.runes, r)
+		prev = r
 	}
-	if currentX > greatestX {
-		return currentX
+	flush()
+
+	var blockWidth float32
+	if t.WordWrap {
+		blockWidth = t.MaxWidth
+	}
+	for _, ln := range lines {
+		if ln.width > blockWidth {
+			blockWidth = ln.width
+		}
+	}
+	for i := range lines {
+		extra := blockWidth - lines[i].width
+		switch t.HAlign {
+		case AlignCenter:
+			lines[i].xOffset = extra / 2
+		case AlignRight:
+			lines[i].xOffset = extra
+		case AlignJustify:
+			if lines[i].wordGaps > 0 && i != len(lines)-1 {
+				lines[i].justifyGap = extra / float32(lines[i].wordGaps)
+			}
+		}
 	}
-	return greatestX
+
+	return lines
 }
 
-// Height returns the height the Text generated from a FontAtlas. This implements the common.Drawable interface.
-func (t Text) Height() float32 {
+// Text represents a string drawn onto the screen, as used by the `TextShader`.
+type Text struct {
+	// Font is the reference to the font you're using to render this. This includes the color, as well as the font size.
+	Font *Font
+	// Text is the actual text you want to draw. This may include newlines (\n).
+	Text string
+	// LineSpacing is the amount of additional spacing there is between the lines (when `Text` consists of multiple lines),
+	// relative to the `Size` of the `Font`.
+	LineSpacing float32
+	// LetterSpacing is the amount of additional spacing there is between the characters, relative to the `Size` of
+	// the `Font`.
+	LetterSpacing float32
+	// RightToLeft is an experimental variable used to indicate that subsequent characters come to the left of the
+	// previous character.
+	RightToLeft bool
+	WordWrap    bool
+	MaxWidth    float32
+	// Tracking, when non-zero, overrides the Font's own kerning table: every
+	// pair of consecutive glyphs is nudged by exactly this many pixels
+	// instead of whatever FontAtlas.Kerning has cached for that pair. Leave
+	// it at 0 to use the font's kerning (or none, if Font.Kerning is false).
+	Tracking float32
+	// HAlign controls how each line is positioned within the block's overall
+	// width. Defaults to AlignLeft.
+	HAlign HAlign
+	// VAlign controls which part of the block `SpaceComponent.Position`
+	// anchors to. Defaults to AlignTop, matching Text's historical behavior.
+	VAlign VAlign
+}
+
+// kernAdjust returns the pixel adjustment to apply between prev and curr:
+// t.Tracking if it's set, otherwise atlas's cached kerning for that pair
+// (none if t.Font.Kerning is false).
+func (t Text) kernAdjust(atlas FontAtlas, prev, curr rune) float32 {
+	if prev == 0 {
+		return 0
+	}
+	if t.Tracking != 0 {
+		return t.Tracking
+	}
+	if !t.Font.Kerning {
+		return 0
+	}
+	return atlas.Kerning[[2]rune{prev, curr}]
+}
+
+// Texture returns nil because the Text is generated from a FontAtlas. This implements the common.Drawable interface.
+func (t Text) Texture() *gl.Texture { return nil }
+
+// atlasFor returns the FontAtlas Text should use, generating and caching one
+// from t.Font's Letters (or the package default Letters) if it hasn't been
+// built yet.
+func (t Text) atlasFor() FontAtlas {
 	atlas, ok := atlasCache[t.Font]
 	if !ok {
-		// Generate texture first
 		if t.Font.Letters == "" {
 			atlas = t.Font.generateFontAtlas(Letters)
 		} else {
@@ -365,53 +749,27 @@ func (t Text) Height() float32 {
 		}
 		atlasCache[t.Font] = atlas
 	}
+	return atlas
+}
 
-	var currentX float32
-	var currentY float32
-	var totalY float32
-	var tallest float32
-
-	runes := []rune(t.Text)
-	for index, char := range runes {
-		// analyze wordwrap
-		if t.WordWrap && char == ' ' {
-			futureWidth := float32(0)
-			for idx := index+1; idx < len(runes); idx++ {
-				if r := runes[idx]; r == ' ' || r == '\n' {
-					break
-				}
-				futureWidth += atlas.Width[runes[idx]] + float32(t.Font.Size)*t.LetterSpacing
-			}
-			if t.MaxWidth < currentX + atlas.Width[char] + float32(t.Font.Size)*t.LetterSpacing + futureWidth {
-				currentX = 0
-				if tallest == 0 {
-					tallest = atlas.Height['q'] + t.LineSpacing*atlas.Height['q']
-				}
-				totalY += tallest
-				tallest = float32(0)
-				continue
-			}
-		}
-		// TODO: this might not work for all characters
-		switch {
-		case char == '\n':
-			if tallest == 0 {
-				tallest = atlas.Height['q'] + t.LineSpacing*atlas.Height['q']
-			}
-			totalY += tallest
-			tallest = float32(0)
-			currentX = 0
-			continue
-		case char < ' ': // all system stuff should be ignored
-			continue
-		}
-		currentX += atlas.Width[char] + float32(t.Font.Size)*t.LetterSpacing
-		currentY = atlas.Height[char] + t.LineSpacing*atlas.Height[char]
-		if currentY > tallest {
-			tallest = currentY
+// Width returns the width of the Text generated from a FontAtlas. This implements the common.Drawable interface.
+func (t Text) Width() float32 {
+	var greatest float32
+	for _, line := range layoutText(t, t.atlasFor()) {
+		if line.width > greatest {
+			greatest = line.width
 		}
 	}
-	return totalY + tallest
+	return greatest
+}
+
+// Height returns the height the Text generated from a FontAtlas. This implements the common.Drawable interface.
+func (t Text) Height() float32 {
+	var total float32
+	for _, line := range layoutText(t, t.atlasFor()) {
+		total += line.height
+	}
+	return total
 }
 
 // View returns 0, 0, 1, 1 because the Text is generated from a FontAtlas. This implements the common.Drawable interface.
@@ -0,0 +1,288 @@
+// Command fontgen bakes a TrueType font file into a Go source file containing a
+// precomputed common.FontAtlas, so that shipped binaries can render text without
+// paying for generateFontAtlas's work the first time a scene draws text. The
+// font is parsed with freetype/truetype, so .otf files with TrueType outlines
+// work but CFF-flavored OpenType fonts (header "OTTO") are rejected. Note
+// that this only avoids the runtime rasterization cost, not the freetype/truetype
+// build-time dependency: common still imports those packages for Create and
+// CreatePreloaded regardless of whether a binary calls LoadPrecomputedAtlas.
+//
+// It mirrors the approach of freetype's genbasicfont: walk the requested rune
+// range, render each glyph into a tightly-packed image via a font.Drawer, and
+// emit the resulting pixels alongside the atlas's position/size tables and
+// metrics, formatted through go/format.
+//
+// Usage:
+//
+//	fontgen -in DejaVuSans.ttf -out dejavusans_atlas.go -pkg assets -var DejaVuSans -size 24 -runes 0x20-0x7e
+//
+// The generated file defines `<var>Atlas common.FontAtlas` and `<var>Pix []byte`
+// (the atlas's raw NRGBA pixels). Register them at init time with:
+//
+//	font.LoadPrecomputedAtlas(assets.DejaVuSansAtlas, assets.DejaVuSansPix)
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/format"
+	"image"
+	"image/draw"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang/freetype"
+	"github.com/golang/freetype/truetype"
+	"golang.org/x/image/font"
+	"golang.org/x/image/math/fixed"
+)
+
+var (
+	in      = flag.String("in", "", "path to the TrueType-outline (.ttf/.otf) font file to bake")
+	out     = flag.String("out", "", "path of the Go source file to write")
+	pkgName = flag.String("pkg", "main", "package name of the generated file")
+	varName = flag.String("var", "Atlas", "identifier prefix for the generated FontAtlas/pixel variables")
+	size    = flag.Float64("size", 24, "font size, in points, to bake")
+	dpi     = flag.Float64("dpi", 72, "DPI to bake the font at")
+	runes   = flag.String("runes", "0x20-0x7e", "comma-separated list of rune ranges (lo-hi) to bake")
+)
+
+func main() {
+	flag.Parse()
+	if *in == "" || *out == "" {
+		fmt.Fprintln(os.Stderr, "fontgen: -in and -out are required")
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	rs, err := parseRuneRanges(*runes)
+	if err != nil {
+		log.Fatalf("fontgen: %v", err)
+	}
+
+	fontBytes, err := ioutil.ReadFile(*in)
+	if err != nil {
+		log.Fatalf("fontgen: %v", err)
+	}
+	ttf, err := freetype.ParseFont(fontBytes)
+	if err != nil {
+		log.Fatalf("fontgen: %v", err)
+	}
+
+	face := truetype.NewFace(ttf, &truetype.Options{
+		Size:    *size,
+		DPI:     *dpi,
+		Hinting: font.HintingNone,
+	})
+
+	ppem := fixed.Int26_6(*size * *dpi / 72 * 64)
+	atlas, pix, err := bakeAtlas(face, ttf, ppem, rs)
+	if err != nil {
+		log.Fatalf("fontgen: %v", err)
+	}
+
+	src, err := render(*pkgName, *varName, atlas, pix)
+	if err != nil {
+		log.Fatalf("fontgen: %v", err)
+	}
+	if err := ioutil.WriteFile(*out, src, 0644); err != nil {
+		log.Fatalf("fontgen: %v", err)
+	}
+}
+
+// parseRuneRanges parses a comma-separated list of "lo-hi" rune ranges, such as
+// "0x20-0x7e,0x3b1-0x3c9", into the sorted set of runes they cover.
+func parseRuneRanges(s string) ([]rune, error) {
+	var rs []rune
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		bounds := strings.SplitN(part, "-", 2)
+		lo, err := strconv.ParseInt(bounds[0], 0, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rune range %q: %v", part, err)
+		}
+		hi := lo
+		if len(bounds) == 2 {
+			hi, err = strconv.ParseInt(bounds[1], 0, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid rune range %q: %v", part, err)
+			}
+		}
+		for r := lo; r <= hi; r++ {
+			rs = append(rs, rune(r))
+		}
+	}
+	sort.Slice(rs, func(i, j int) bool { return rs[i] < rs[j] })
+	uniq := rs[:0]
+	for _, r := range rs {
+		if len(uniq) == 0 || r != uniq[len(uniq)-1] {
+			uniq = append(uniq, r)
+		}
+	}
+	return uniq, nil
+}
+
+// bakedAtlas is the subset of common.FontAtlas that fontgen knows how to fill in;
+// it's declared locally so this tool doesn't need to import the common package.
+type bakedAtlas struct {
+	XLocation   map[rune]float32
+	YLocation   map[rune]float32
+	Width       map[rune]float32
+	Height      map[rune]float32
+	TotalWidth  float32
+	TotalHeight float32
+	Ascent      float32
+	Descent     float32
+	LineHeight  float32
+	Kerning     map[[2]rune]float32
+}
+
+// bakeAtlas packs the requested runes using the same layout generateFontAtlas uses
+// at runtime, so a precomputed atlas lines up with one generated on the fly. ppem
+// is the font size in 26.6 fixed-point pixels, used to look up ttf's kerning table
+// the same way Font.kern does at runtime.
+func bakeAtlas(face font.Face, ttf *truetype.Font, ppem fixed.Int26_6, rs []rune) (bakedAtlas, []byte, error) {
+	atlas := bakedAtlas{
+		XLocation: make(map[rune]float32, len(rs)),
+		YLocation: make(map[rune]float32, len(rs)),
+		Width:     make(map[rune]float32, len(rs)),
+		Height:    make(map[rune]float32, len(rs)),
+	}
+
+	d := &font.Drawer{Face: face}
+	metrics := face.Metrics()
+	lineHeight := fixed.Int26_6(int32(metrics.Height) + 2)
+	lineBuffer := float32(lineHeight.Ceil()) / 2
+	xBuffer := float32(10)
+
+	currentX := float32(0)
+	currentY := float32(0)
+
+	for idxr, r := range rs {
+		_, adv, ok := d.Face.GlyphBounds(r)
+		if !ok {
+			continue
+		}
+		currentX += xBuffer
+
+		atlas.Width[r] = float32(adv.Ceil())
+		atlas.Height[r] = float32(lineHeight.Ceil()) + lineBuffer
+		atlas.XLocation[r] = currentX
+		atlas.YLocation[r] = currentY
+
+		currentX += float32(adv.Ceil()) + xBuffer
+		if currentX > atlas.TotalWidth {
+			atlas.TotalWidth = currentX
+		}
+
+		if currentX > 1024 || idxr >= len(rs)-1 {
+			currentX = 0
+			currentY += float32(lineHeight.Ceil()) + lineBuffer
+			atlas.TotalHeight += float32(lineHeight.Ceil()) + lineBuffer
+		}
+	}
+	atlas.Ascent = float32(metrics.Ascent.Ceil())
+	atlas.Descent = float32(metrics.Descent.Ceil())
+	atlas.LineHeight = float32(lineHeight.Ceil()) + lineBuffer
+
+	atlas.Kerning = make(map[[2]rune]float32)
+	for _, prev := range rs {
+		for _, curr := range rs {
+			if k := ttf.Kern(ppem, ttf.Index(prev), ttf.Index(curr)); k != 0 {
+				atlas.Kerning[[2]rune{prev, curr}] = float32(k) / 64
+			}
+		}
+	}
+
+	img := image.NewNRGBA(image.Rect(0, 0, int(atlas.TotalWidth), int(atlas.TotalHeight)))
+	draw.Draw(img, img.Bounds(), image.Transparent, image.ZP, draw.Src)
+	d.Dst = img
+	d.Src = image.Black
+
+	for _, r := range rs {
+		if _, _, ok := d.Face.GlyphBounds(r); !ok {
+			continue
+		}
+		d.Dot = fixed.P(int(atlas.XLocation[r]), int(atlas.YLocation[r]+float32(lineHeight.Ceil())))
+		d.DrawBytes([]byte(string(r)))
+	}
+
+	return atlas, img.Pix, nil
+}
+
+// render emits the generated source defining "<var>Atlas common.FontAtlas" and
+// "<var>Pix []byte", then runs it through go/format.
+func render(pkg, name string, atlas bakedAtlas, pix []byte) ([]byte, error) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "// Code generated by fontgen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package %s\n\n", pkg)
+	fmt.Fprintf(&b, "import \"github.com/EngoEngine/engo/common\"\n\n")
+
+	fmt.Fprintf(&b, "// %sAtlas is a precomputed common.FontAtlas baked by fontgen. Register it with\n", name)
+	fmt.Fprintf(&b, "// (*common.Font).LoadPrecomputedAtlas to skip generating it again at runtime.\n")
+	fmt.Fprintf(&b, "var %sAtlas = common.FontAtlas{\n", name)
+	writeRuneMap(&b, "XLocation", atlas.XLocation)
+	writeRuneMap(&b, "YLocation", atlas.YLocation)
+	writeRuneMap(&b, "Width", atlas.Width)
+	writeRuneMap(&b, "Height", atlas.Height)
+	fmt.Fprintf(&b, "\tTotalWidth: %v,\n", atlas.TotalWidth)
+	fmt.Fprintf(&b, "\tTotalHeight: %v,\n", atlas.TotalHeight)
+	fmt.Fprintf(&b, "\tAscent: %v,\n", atlas.Ascent)
+	fmt.Fprintf(&b, "\tDescent: %v,\n", atlas.Descent)
+	fmt.Fprintf(&b, "\tLineHeight: %v,\n", atlas.LineHeight)
+	writeKerningMap(&b, atlas.Kerning)
+	fmt.Fprintf(&b, "}\n\n")
+
+	fmt.Fprintf(&b, "// %sPix holds the raw NRGBA pixels for %sAtlas, row-major with a stride of\n", name, name)
+	fmt.Fprintf(&b, "// 4*TotalWidth bytes per row.\n")
+	fmt.Fprintf(&b, "var %sPix = []byte{", name)
+	for i, p := range pix {
+		if i%16 == 0 {
+			b.WriteString("\n\t")
+		}
+		fmt.Fprintf(&b, "0x%02x, ", p)
+	}
+	fmt.Fprintf(&b, "\n}\n")
+
+	return format.Source([]byte(b.String()))
+}
+
+func writeRuneMap(b *strings.Builder, field string, m map[rune]float32) {
+	keys := make([]rune, 0, len(m))
+	for r := range m {
+		keys = append(keys, r)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+	fmt.Fprintf(b, "\t%s: map[rune]float32{\n", field)
+	for _, r := range keys {
+		fmt.Fprintf(b, "\t\t%d: %v, // %q\n", r, m[r], r)
+	}
+	fmt.Fprintf(b, "\t},\n")
+}
+
+func writeKerningMap(b *strings.Builder, m map[[2]rune]float32) {
+	keys := make([][2]rune, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i][0] != keys[j][0] {
+			return keys[i][0] < keys[j][0]
+		}
+		return keys[i][1] < keys[j][1]
+	})
+
+	fmt.Fprintf(b, "\tKerning: map[[2]rune]float32{\n")
+	for _, k := range keys {
+		fmt.Fprintf(b, "\t\t{%d, %d}: %v, // %q, %q\n", k[0], k[1], m[k], k[0], k[1])
+	}
+	fmt.Fprintf(b, "\t},\n")
+}